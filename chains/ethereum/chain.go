@@ -0,0 +1,56 @@
+package ethereum
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// Chain is the ethereum-side ProvableChain implementation: it wraps an
+// ethclient.Client along with the pluggable pieces (Signer, GasEstimator,
+// fee market config) used to build and submit transactions.
+type Chain struct {
+	chainID *big.Int
+	client  *ethclient.Client
+
+	signer       Signer
+	gasEstimator GasEstimator
+	dynamicFee   DynamicFeeConfig
+	feeOracle    FeeOracle
+}
+
+// ChainOption configures optional pieces of a Chain at construction time.
+type ChainOption func(*Chain)
+
+// WithGasEstimator configures chain to estimate gas limits via estimator
+// instead of the fixed defaultGasLimit.
+func WithGasEstimator(estimator GasEstimator) ChainOption {
+	return func(c *Chain) { c.gasEstimator = estimator }
+}
+
+// WithDynamicFee opts chain into EIP-1559 transactions per cfg, suggesting
+// GasFeeCap/GasTipCap via oracle.
+func WithDynamicFee(cfg DynamicFeeConfig, oracle FeeOracle) ChainOption {
+	return func(c *Chain) {
+		c.dynamicFee = cfg
+		c.feeOracle = oracle
+	}
+}
+
+// NewChain returns a Chain that submits transactions via client, signed by
+// signer, for the chain identified by chainIDStr (its decimal chain ID).
+func NewChain(client *ethclient.Client, chainIDStr string, signer Signer, opts ...ChainOption) (*Chain, error) {
+	chainID, err := parseChainID(chainIDStr)
+	if err != nil {
+		return nil, err
+	}
+	c := &Chain{
+		chainID: chainID,
+		client:  client,
+		signer:  signer,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c, nil
+}
@@ -2,18 +2,21 @@ package ethereum
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"math/big"
 
+	geth "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
 	gethtypes "github.com/ethereum/go-ethereum/core/types"
-	gethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 )
 
+// defaultGasLimit is used when chain has no GasEstimator configured,
+// preserving the relayer's previous fixed-gas-limit behavior.
+const defaultGasLimit = 6382056
+
 func NewETHClient(endpoint string) (*ethclient.Client, error) {
 	conn, err := rpc.DialHTTP(endpoint)
 	if err != nil {
@@ -33,27 +36,87 @@ func parseChainID(idStr string) (*big.Int, error) {
 
 func (chain *Chain) CallOpts(ctx context.Context) *bind.CallOpts {
 	return &bind.CallOpts{
-		From:    gethcrypto.PubkeyToAddress(chain.relayerPrvKey.PublicKey),
+		From:    chain.signer.Address(),
 		Context: ctx,
 	}
 }
 
-func (chain *Chain) TxOpts(ctx context.Context) *bind.TransactOpts {
-	signer := gethtypes.NewEIP155Signer(chain.chainID)
-	prv := chain.relayerPrvKey
-	addr := gethcrypto.PubkeyToAddress(prv.PublicKey)
-	return &bind.TransactOpts{
+// TxOpts returns bind.TransactOpts for submitting msg, with the gas limit
+// estimated via chain.gasEstimator (falling back to defaultGasLimit if none
+// is configured) and, if chain.dynamicFee is enabled, GasFeeCap/GasTipCap
+// populated from chain.feeOracle.
+func (chain *Chain) TxOpts(ctx context.Context, msg geth.CallMsg) (*bind.TransactOpts, error) {
+	addr := chain.signer.Address()
+	msg.From = addr
+
+	gasLimit := uint64(defaultGasLimit)
+	if chain.gasEstimator != nil {
+		estimated, err := chain.gasEstimator.EstimateGas(ctx, msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to estimate gas limit: %w", err)
+		}
+		gasLimit = estimated
+	}
+
+	opts := &bind.TransactOpts{
 		From:     addr,
-		GasLimit: 6382056,
+		GasLimit: gasLimit,
 		Signer: func(address common.Address, tx *gethtypes.Transaction) (*gethtypes.Transaction, error) {
 			if address != addr {
-				return nil, errors.New("not authorized to sign this account")
-			}
-			signature, err := gethcrypto.Sign(signer.Hash(tx).Bytes(), prv)
-			if err != nil {
-				return nil, err
+				return nil, fmt.Errorf("not authorized to sign this account")
 			}
-			return tx.WithSignature(signer, signature)
+			return chain.signer.SignTx(ctx, tx, chain.chainID)
 		},
 	}
+
+	if chain.dynamicFee.Enabled {
+		if chain.feeOracle == nil {
+			return nil, fmt.Errorf("dynamic fee is enabled but no FeeOracle is configured")
+		}
+		gasFeeCap, gasTipCap, err := chain.feeOracle.SuggestFees(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to suggest fees: %w", err)
+		}
+		opts.GasFeeCap = gasFeeCap
+		opts.GasTipCap = gasTipCap
+	}
+	return opts, nil
+}
+
+// SendTx builds a transaction via buildTx and submits it. When
+// chain.dynamicFee is enabled, it retries with a bumped GasTipCap (per
+// chain.dynamicFee.RetryBumpPercent) whenever the node rejects it as a
+// "replacement transaction underpriced", making at most 5 attempts in all;
+// otherwise the transaction is submitted once, with no retry.
+func (chain *Chain) SendTx(ctx context.Context, msg geth.CallMsg, buildTx func(opts *bind.TransactOpts) (*gethtypes.Transaction, error)) (*gethtypes.Transaction, error) {
+	opts, err := chain.TxOpts(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	var sent *gethtypes.Transaction
+	send := func(tipCap *big.Int) error {
+		if tipCap != nil {
+			opts.GasTipCap = tipCap
+		}
+		tx, err := buildTx(opts)
+		if err != nil {
+			return err
+		}
+		if err := chain.client.SendTransaction(ctx, tx); err != nil {
+			return err
+		}
+		sent = tx
+		return nil
+	}
+
+	if chain.dynamicFee.Enabled && opts.GasTipCap != nil {
+		const maxRetries = 5
+		if err := RetrySend(&chain.dynamicFee, opts.GasTipCap, maxRetries, send); err != nil {
+			return nil, err
+		}
+	} else if err := send(opts.GasTipCap); err != nil {
+		return nil, err
+	}
+	return sent, nil
 }
@@ -0,0 +1,141 @@
+package ethereum
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// DynamicFeeConfig toggles and tunes EIP-1559 dynamic-fee transactions for a
+// Chain. The zero value keeps the previous legacy-transaction behavior.
+type DynamicFeeConfig struct {
+	// Enabled opts the chain into EIP-1559 transactions. Legacy chains that
+	// don't support the fee market should leave this false.
+	Enabled bool
+	// TipPercentile selects which percentile of recent priority fees (as
+	// returned by eth_feeHistory) to use as the suggested GasTipCap, e.g. 50
+	// for the median. Defaults to 50 if unset.
+	TipPercentile float64
+	// RetryBumpPercent is the percentage by which GasTipCap is increased on
+	// each retry after a "replacement transaction underpriced" error, e.g.
+	// 10 for a 10% bump. Defaults to 10 if unset.
+	RetryBumpPercent int64
+}
+
+func (c *DynamicFeeConfig) percentile() float64 {
+	if c == nil || c.TipPercentile <= 0 {
+		return 50
+	}
+	return c.TipPercentile
+}
+
+func (c *DynamicFeeConfig) retryBumpPercent() int64 {
+	if c == nil || c.RetryBumpPercent <= 0 {
+		return 10
+	}
+	return c.RetryBumpPercent
+}
+
+// FeeOracle suggests gas fee parameters for EIP-1559 transactions.
+type FeeOracle interface {
+	// SuggestFees returns a GasFeeCap and GasTipCap suitable for inclusion
+	// in the next few blocks.
+	SuggestFees(ctx context.Context) (gasFeeCap, gasTipCap *big.Int, err error)
+}
+
+// FeeHistoryOracle implements FeeOracle by polling eth_feeHistory and taking
+// the configured percentile of recent priority fees, padding the base fee to
+// account for it rising over the next few blocks.
+type FeeHistoryOracle struct {
+	client *ethclient.Client
+	cfg    *DynamicFeeConfig
+}
+
+// NewFeeHistoryOracle returns a FeeOracle backed by client, tuned by cfg.
+func NewFeeHistoryOracle(client *ethclient.Client, cfg *DynamicFeeConfig) *FeeHistoryOracle {
+	return &FeeHistoryOracle{client: client, cfg: cfg}
+}
+
+func (o *FeeHistoryOracle) SuggestFees(ctx context.Context) (*big.Int, *big.Int, error) {
+	const feeHistoryBlocks = 10
+	history, err := o.client.FeeHistory(ctx, feeHistoryBlocks, nil, []float64{o.cfg.percentile()})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to fetch fee history: %w", err)
+	}
+	if len(history.Reward) == 0 || len(history.BaseFee) == 0 {
+		return nil, nil, fmt.Errorf("fee history returned no data")
+	}
+
+	tip := averageTip(history.Reward)
+	// Last entry of BaseFee is the estimated base fee for the next block.
+	nextBaseFee := history.BaseFee[len(history.BaseFee)-1]
+	gasFeeCap := suggestGasFeeCap(nextBaseFee, tip)
+
+	return gasFeeCap, tip, nil
+}
+
+// averageTip averages the first (and only requested) percentile column of
+// per-block priority fees returned by eth_feeHistory, skipping blocks with
+// no reward data (e.g. empty blocks).
+func averageTip(rewards [][]*big.Int) *big.Int {
+	tip := new(big.Int)
+	counted := 0
+	for _, blockRewards := range rewards {
+		if len(blockRewards) == 0 {
+			continue
+		}
+		tip.Add(tip, blockRewards[0])
+		counted++
+	}
+	if counted == 0 {
+		return tip
+	}
+	return tip.Div(tip, big.NewInt(int64(counted)))
+}
+
+// suggestGasFeeCap pads nextBaseFee by 2x so the resulting GasFeeCap
+// tolerates the base fee rising over the next few blocks, then adds tip on
+// top so the cap still leaves room for the priority fee.
+func suggestGasFeeCap(nextBaseFee, tip *big.Int) *big.Int {
+	return new(big.Int).Add(new(big.Int).Mul(nextBaseFee, big.NewInt(2)), tip)
+}
+
+// BumpTipForRetry increases tip by the configured retry percentage, for use
+// when resubmitting a transaction that failed with "replacement transaction
+// underpriced".
+func (c *DynamicFeeConfig) BumpTipForRetry(tip *big.Int) *big.Int {
+	bump := c.retryBumpPercent()
+	bumped := new(big.Int).Mul(tip, big.NewInt(100+bump))
+	return bumped.Div(bumped, big.NewInt(100))
+}
+
+// IsReplacementUnderpriced returns true if err indicates the node rejected a
+// transaction because its fee did not exceed the previous attempt's by
+// enough, signaling the caller should bump GasTipCap and retry.
+func IsReplacementUnderpriced(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "replacement transaction underpriced")
+}
+
+// RetrySend calls send with successively bumped tip caps, starting from
+// gasTipCap, until send succeeds, returns a non-retryable error, or
+// maxAttempts is exceeded. It is the retry loop behind chunk0-4's "bump
+// GasTipCap on replacement transaction underpriced" requirement; see
+// (*Chain).SendTx for the call site.
+func RetrySend(cfg *DynamicFeeConfig, gasTipCap *big.Int, maxAttempts int, send func(tipCap *big.Int) error) error {
+	tip := gasTipCap
+	var err error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		err = send(tip)
+		if err == nil {
+			return nil
+		}
+		if !IsReplacementUnderpriced(err) {
+			return err
+		}
+		tip = cfg.BumpTipForRetry(tip)
+	}
+	return fmt.Errorf("exceeded %d attempts bumping gas tip cap, last error: %w", maxAttempts, err)
+}
@@ -0,0 +1,108 @@
+package ethereum
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func TestBumpTipForRetry(t *testing.T) {
+	cfg := &DynamicFeeConfig{RetryBumpPercent: 10}
+	got := cfg.BumpTipForRetry(big.NewInt(1000))
+	if want := big.NewInt(1100); got.Cmp(want) != 0 {
+		t.Errorf("BumpTipForRetry(1000) = %s, want %s", got, want)
+	}
+}
+
+func TestBumpTipForRetryDefaultPercent(t *testing.T) {
+	cfg := &DynamicFeeConfig{}
+	got := cfg.BumpTipForRetry(big.NewInt(1000))
+	if want := big.NewInt(1100); got.Cmp(want) != 0 {
+		t.Errorf("BumpTipForRetry(1000) with default config = %s, want %s", got, want)
+	}
+}
+
+func TestIsReplacementUnderpriced(t *testing.T) {
+	cases := []struct {
+		err  error
+		want bool
+	}{
+		{nil, false},
+		{errors.New("replacement transaction underpriced"), true},
+		{errors.New("insufficient funds for gas * price + value"), false},
+	}
+	for _, c := range cases {
+		if got := IsReplacementUnderpriced(c.err); got != c.want {
+			t.Errorf("IsReplacementUnderpriced(%v) = %v, want %v", c.err, got, c.want)
+		}
+	}
+}
+
+func TestRetrySendBumpsTipOnUnderpriced(t *testing.T) {
+	cfg := &DynamicFeeConfig{RetryBumpPercent: 10}
+	var seenTips []int64
+	attempt := 0
+	err := RetrySend(cfg, big.NewInt(1000), 5, func(tip *big.Int) error {
+		seenTips = append(seenTips, tip.Int64())
+		attempt++
+		if attempt < 3 {
+			return errors.New("replacement transaction underpriced")
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("RetrySend returned error: %v", err)
+	}
+	want := []int64{1000, 1100, 1210}
+	if len(seenTips) != len(want) {
+		t.Fatalf("got %d attempts %v, want %d attempts %v", len(seenTips), seenTips, len(want), want)
+	}
+	for i := range want {
+		if seenTips[i] != want[i] {
+			t.Errorf("attempt %d tip = %d, want %d", i, seenTips[i], want[i])
+		}
+	}
+}
+
+func TestRetrySendStopsOnNonRetryableError(t *testing.T) {
+	cfg := &DynamicFeeConfig{}
+	wantErr := errors.New("nonce too low")
+	attempts := 0
+	err := RetrySend(cfg, big.NewInt(1000), 5, func(tip *big.Int) error {
+		attempts++
+		return wantErr
+	})
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("RetrySend error = %v, want %v", err, wantErr)
+	}
+	if attempts != 1 {
+		t.Errorf("expected 1 attempt for a non-retryable error, got %d", attempts)
+	}
+}
+
+func TestAverageTip(t *testing.T) {
+	rewards := [][]*big.Int{
+		{big.NewInt(100)},
+		{},
+		{big.NewInt(200)},
+		{big.NewInt(300)},
+	}
+	got := averageTip(rewards)
+	if want := big.NewInt(200); got.Cmp(want) != 0 {
+		t.Errorf("averageTip(%v) = %s, want %s", rewards, got, want)
+	}
+}
+
+func TestAverageTipAllEmpty(t *testing.T) {
+	got := averageTip([][]*big.Int{{}, {}})
+	if want := big.NewInt(0); got.Cmp(want) != 0 {
+		t.Errorf("averageTip(all empty) = %s, want %s", got, want)
+	}
+}
+
+func TestSuggestGasFeeCap(t *testing.T) {
+	got := suggestGasFeeCap(big.NewInt(100), big.NewInt(5))
+	if want := big.NewInt(205); got.Cmp(want) != 0 {
+		t.Errorf("suggestGasFeeCap(100, 5) = %s, want %s", got, want)
+	}
+}
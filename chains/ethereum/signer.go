@@ -0,0 +1,224 @@
+package ethereum
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	geth "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+	gethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Signer abstracts how transactions sent by the relayer's ethereum Chain are
+// signed, so that raw private keys, local keystore files, remote signers
+// (clef) and HSM/KMS-backed signers can all be plugged in interchangeably.
+type Signer interface {
+	// Address returns the account address this Signer signs for.
+	Address() common.Address
+	// SignTx signs tx for chainID and returns the signed transaction.
+	SignTx(ctx context.Context, tx *gethtypes.Transaction, chainID *big.Int) (*gethtypes.Transaction, error)
+}
+
+// GasEstimator estimates the gas limit for a transaction, replacing the
+// previous hardcoded gas limit.
+type GasEstimator interface {
+	// EstimateGas returns the gas limit to use for msg, typically derived
+	// from eth_estimateGas with some safety multiplier applied.
+	EstimateGas(ctx context.Context, msg geth.CallMsg) (uint64, error)
+}
+
+// RawKeySigner is the backwards-compatible Signer backed by an in-memory
+// *ecdsa.PrivateKey, matching the relayer's previous behavior.
+type RawKeySigner struct {
+	key  *ecdsa.PrivateKey
+	addr common.Address
+}
+
+// NewRawKeySigner returns a Signer that signs with key directly.
+func NewRawKeySigner(key *ecdsa.PrivateKey) *RawKeySigner {
+	return &RawKeySigner{key: key, addr: gethcrypto.PubkeyToAddress(key.PublicKey)}
+}
+
+func (s *RawKeySigner) Address() common.Address { return s.addr }
+
+func (s *RawKeySigner) SignTx(ctx context.Context, tx *gethtypes.Transaction, chainID *big.Int) (*gethtypes.Transaction, error) {
+	signer := gethtypes.LatestSignerForChainID(chainID)
+	signature, err := gethcrypto.Sign(signer.Hash(tx).Bytes(), s.key)
+	if err != nil {
+		return nil, err
+	}
+	return tx.WithSignature(signer, signature)
+}
+
+// KeystoreSigner signs using an account unlocked in a local go-ethereum
+// keystore (accounts/keystore), e.g. a file created with `geth account new`.
+type KeystoreSigner struct {
+	ks      *keystore.KeyStore
+	account accounts.Account
+}
+
+// NewKeystoreSigner opens the keystore at keystoreDir and returns a Signer
+// for the account with the given address, unlocked with passphrase.
+func NewKeystoreSigner(keystoreDir, address, passphrase string) (*KeystoreSigner, error) {
+	ks := keystore.NewKeyStore(keystoreDir, keystore.StandardScryptN, keystore.StandardScryptP)
+	account := accounts.Account{Address: common.HexToAddress(address)}
+	account, err := ks.Find(account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find account %s in keystore %s: %w", address, keystoreDir, err)
+	}
+	if err := ks.Unlock(account, passphrase); err != nil {
+		return nil, fmt.Errorf("failed to unlock account %s: %w", address, err)
+	}
+	return &KeystoreSigner{ks: ks, account: account}, nil
+}
+
+func (s *KeystoreSigner) Address() common.Address { return s.account.Address }
+
+func (s *KeystoreSigner) SignTx(ctx context.Context, tx *gethtypes.Transaction, chainID *big.Int) (*gethtypes.Transaction, error) {
+	return s.ks.SignTx(s.account, tx, chainID)
+}
+
+// RemoteSigner signs by delegating to a remote signer process (e.g. clef)
+// reachable over JSON-RPC, via the standard eth_signTransaction/
+// account_signTransaction method exposed by the remote endpoint.
+type RemoteSigner struct {
+	client *rpc.Client
+	addr   common.Address
+	method string
+}
+
+// NewRemoteSigner dials endpoint (e.g. a clef "http://localhost:8550" or UNIX
+// socket address) and returns a Signer for address that calls method (e.g.
+// "account_signTransaction") to produce signatures.
+func NewRemoteSigner(endpoint, address, method string) (*RemoteSigner, error) {
+	client, err := rpc.Dial(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dial remote signer at %s: %w", endpoint, err)
+	}
+	if method == "" {
+		method = "account_signTransaction"
+	}
+	return &RemoteSigner{client: client, addr: common.HexToAddress(address), method: method}, nil
+}
+
+func (s *RemoteSigner) Address() common.Address { return s.addr }
+
+// transactionArgs mirrors the TransactionArgs shape expected by
+// eth_signTransaction/account_signTransaction: all numeric and byte fields
+// are hex-encoded, and legacy vs. dynamic-fee transactions populate
+// different subsets of the fee fields.
+type transactionArgs struct {
+	From                 common.Address  `json:"from"`
+	To                   *common.Address `json:"to,omitempty"`
+	Gas                  hexutil.Uint64  `json:"gas"`
+	GasPrice             *hexutil.Big    `json:"gasPrice,omitempty"`
+	MaxFeePerGas         *hexutil.Big    `json:"maxFeePerGas,omitempty"`
+	MaxPriorityFeePerGas *hexutil.Big    `json:"maxPriorityFeePerGas,omitempty"`
+	Value                *hexutil.Big    `json:"value"`
+	Nonce                hexutil.Uint64  `json:"nonce"`
+	Data                 hexutil.Bytes   `json:"data"`
+	ChainID              *hexutil.Big    `json:"chainId,omitempty"`
+}
+
+// signTransactionResult mirrors the SignTransactionResult shape returned by
+// eth_signTransaction/account_signTransaction: the raw signed transaction
+// bytes plus its decoded form.
+type signTransactionResult struct {
+	Raw hexutil.Bytes          `json:"raw"`
+	Tx  *gethtypes.Transaction `json:"tx"`
+}
+
+func newTransactionArgs(tx *gethtypes.Transaction, from common.Address, chainID *big.Int) transactionArgs {
+	args := transactionArgs{
+		From:    from,
+		To:      tx.To(),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   (*hexutil.Big)(tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    tx.Data(),
+		ChainID: (*hexutil.Big)(chainID),
+	}
+	if tx.Type() == gethtypes.DynamicFeeTxType {
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+	} else {
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	}
+	return args
+}
+
+func (s *RemoteSigner) SignTx(ctx context.Context, tx *gethtypes.Transaction, chainID *big.Int) (*gethtypes.Transaction, error) {
+	args := newTransactionArgs(tx, s.addr, chainID)
+	var result signTransactionResult
+	if err := s.client.CallContext(ctx, &result, s.method, args); err != nil {
+		return nil, fmt.Errorf("remote signer call failed: %w", err)
+	}
+	if result.Tx == nil {
+		return nil, fmt.Errorf("remote signer returned no signed transaction")
+	}
+	return result.Tx, nil
+}
+
+// KMSSigner signs using a remote key management service (e.g. AWS KMS) or
+// hardware security module fronted by a SignDigest-style API. Consumers
+// provide signDigest, which must return a 65-byte [R || S || V] signature
+// over the given 32-byte digest.
+type KMSSigner struct {
+	addr       common.Address
+	signDigest func(ctx context.Context, digest [32]byte) ([]byte, error)
+}
+
+// NewKMSSigner returns a Signer for addr that produces signatures via
+// signDigest, e.g. a closure calling AWS KMS's Sign API or an HSM's PKCS#11
+// interface.
+func NewKMSSigner(addr common.Address, signDigest func(ctx context.Context, digest [32]byte) ([]byte, error)) *KMSSigner {
+	return &KMSSigner{addr: addr, signDigest: signDigest}
+}
+
+func (s *KMSSigner) Address() common.Address { return s.addr }
+
+func (s *KMSSigner) SignTx(ctx context.Context, tx *gethtypes.Transaction, chainID *big.Int) (*gethtypes.Transaction, error) {
+	signer := gethtypes.LatestSignerForChainID(chainID)
+	digest := signer.Hash(tx)
+	sig, err := s.signDigest(ctx, digest)
+	if err != nil {
+		return nil, err
+	}
+	if len(sig) != 65 {
+		return nil, errors.New("signDigest must return a 65-byte [R || S || V] signature")
+	}
+	return tx.WithSignature(signer, sig)
+}
+
+// DefaultGasEstimator estimates gas via eth_estimateGas and applies a
+// multiplier (e.g. 1.2 for a 20% safety margin) to the result.
+type DefaultGasEstimator struct {
+	client     *ethclient.Client
+	multiplier float64
+}
+
+// NewDefaultGasEstimator returns a GasEstimator backed by client, scaling
+// each eth_estimateGas result by multiplier. A multiplier <= 0 defaults to 1.
+func NewDefaultGasEstimator(client *ethclient.Client, multiplier float64) *DefaultGasEstimator {
+	if multiplier <= 0 {
+		multiplier = 1
+	}
+	return &DefaultGasEstimator{client: client, multiplier: multiplier}
+}
+
+func (e *DefaultGasEstimator) EstimateGas(ctx context.Context, msg geth.CallMsg) (uint64, error) {
+	gas, err := e.client.EstimateGas(ctx, msg)
+	if err != nil {
+		return 0, fmt.Errorf("failed to estimate gas: %w", err)
+	}
+	return uint64(float64(gas) * e.multiplier), nil
+}
@@ -0,0 +1,101 @@
+package ethereum
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+func TestNewTransactionArgsLegacy(t *testing.T) {
+	from := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	chainID := big.NewInt(1)
+
+	tx := gethtypes.NewTx(&gethtypes.LegacyTx{
+		Nonce:    1,
+		GasPrice: big.NewInt(100),
+		Gas:      21000,
+		To:       &to,
+		Value:    big.NewInt(5),
+		Data:     []byte("x"),
+	})
+
+	args := newTransactionArgs(tx, from, chainID)
+
+	if args.GasPrice == nil {
+		t.Fatal("expected GasPrice to be set for a legacy transaction")
+	}
+	if args.MaxFeePerGas != nil || args.MaxPriorityFeePerGas != nil {
+		t.Fatalf("expected no dynamic-fee fields on a legacy transaction, got MaxFeePerGas=%v MaxPriorityFeePerGas=%v", args.MaxFeePerGas, args.MaxPriorityFeePerGas)
+	}
+	if args.From != from || *args.To != to {
+		t.Fatalf("unexpected From/To: %v / %v", args.From, args.To)
+	}
+}
+
+func TestNewTransactionArgsDynamicFee(t *testing.T) {
+	from := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	to := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	chainID := big.NewInt(1)
+
+	tx := gethtypes.NewTx(&gethtypes.DynamicFeeTx{
+		ChainID:   chainID,
+		Nonce:     1,
+		GasTipCap: big.NewInt(2),
+		GasFeeCap: big.NewInt(100),
+		Gas:       21000,
+		To:        &to,
+		Value:     big.NewInt(5),
+		Data:      []byte("y"),
+	})
+
+	args := newTransactionArgs(tx, from, chainID)
+
+	if args.GasPrice != nil {
+		t.Fatalf("expected no GasPrice on a dynamic-fee transaction, got %v", args.GasPrice)
+	}
+	if args.MaxFeePerGas == nil || args.MaxPriorityFeePerGas == nil {
+		t.Fatal("expected MaxFeePerGas and MaxPriorityFeePerGas to be set for a dynamic-fee transaction")
+	}
+	if args.MaxFeePerGas.ToInt().Cmp(big.NewInt(100)) != 0 {
+		t.Errorf("MaxFeePerGas = %s, want 100", args.MaxFeePerGas.ToInt())
+	}
+	if args.MaxPriorityFeePerGas.ToInt().Cmp(big.NewInt(2)) != 0 {
+		t.Errorf("MaxPriorityFeePerGas = %s, want 2", args.MaxPriorityFeePerGas.ToInt())
+	}
+}
+
+func TestKMSSignerSignTxRejectsWrongSignatureLength(t *testing.T) {
+	addr := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	signer := NewKMSSigner(addr, func(ctx context.Context, digest [32]byte) ([]byte, error) {
+		return make([]byte, 64), nil
+	})
+
+	to := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	tx := gethtypes.NewTx(&gethtypes.LegacyTx{Nonce: 0, GasPrice: big.NewInt(1), Gas: 21000, To: &to})
+
+	if _, err := signer.SignTx(context.Background(), tx, big.NewInt(1)); err == nil {
+		t.Fatal("expected an error for a non-65-byte signature")
+	}
+}
+
+func TestKMSSignerSignTxAcceptsValidLength(t *testing.T) {
+	addr := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	signer := NewKMSSigner(addr, func(ctx context.Context, digest [32]byte) ([]byte, error) {
+		return make([]byte, 65), nil
+	})
+
+	to := common.HexToAddress("0x4444444444444444444444444444444444444444")
+	tx := gethtypes.NewTx(&gethtypes.LegacyTx{Nonce: 0, GasPrice: big.NewInt(1), Gas: 21000, To: &to})
+
+	signed, err := signer.SignTx(context.Background(), tx, big.NewInt(1))
+	if err != nil {
+		t.Fatalf("SignTx returned error: %v", err)
+	}
+	if signed == nil {
+		t.Fatal("expected a signed transaction")
+	}
+}
@@ -0,0 +1,48 @@
+package core
+
+// HandshakeOptions configures how a handshake (client, connection or channel)
+// is initiated for a Path. Callers that initiate a handshake (the naive
+// strategy's client/connection/channel init) should call the matching
+// Apply*Options method on the Path before reading its Src/Dst identifiers,
+// so that Override is honored.
+type HandshakeOptions struct {
+	// Override, when true, forces generation of fresh identifiers for the
+	// handshake being initiated even if the PathEnd already has one
+	// configured. This lets an operator re-run a handshake over a path that
+	// was already populated (e.g. to open a second channel) without hand-
+	// editing the path config first.
+	Override bool
+}
+
+// ApplyClientOptions prepares the path for a client handshake according to
+// opts. When opts.Override is set, any ClientID already configured on Src
+// and Dst is discarded and fresh ones are generated; otherwise the path is
+// left untouched so the existing naive-strategy behavior of reusing
+// configured IDs is preserved.
+func (p *Path) ApplyClientOptions(opts HandshakeOptions) {
+	if !opts.Override {
+		return
+	}
+	p.GenSrcClientID()
+	p.GenDstClientID()
+}
+
+// ApplyConnectionOptions prepares the path for a connection handshake
+// according to opts, analogous to ApplyClientOptions.
+func (p *Path) ApplyConnectionOptions(opts HandshakeOptions) {
+	if !opts.Override {
+		return
+	}
+	p.GenSrcConnID()
+	p.GenDstConnID()
+}
+
+// ApplyChannelOptions prepares the path for a channel handshake according to
+// opts, analogous to ApplyClientOptions.
+func (p *Path) ApplyChannelOptions(opts HandshakeOptions) {
+	if !opts.Override {
+		return
+	}
+	p.GenSrcChanID()
+	p.GenDstChanID()
+}
@@ -0,0 +1,53 @@
+package core
+
+import "testing"
+
+func newTestPath() *Path {
+	return &Path{
+		Src: &PathEnd{ChainID: "chainA", ClientID: "existing-client", ConnectionID: "existing-conn", ChannelID: "existing-chan"},
+		Dst: &PathEnd{ChainID: "chainB", ClientID: "existing-client", ConnectionID: "existing-conn", ChannelID: "existing-chan"},
+	}
+}
+
+func TestApplyClientOptionsPreservesExistingByDefault(t *testing.T) {
+	p := newTestPath()
+	p.ApplyClientOptions(HandshakeOptions{Override: false})
+	if p.Src.ClientID != "existing-client" || p.Dst.ClientID != "existing-client" {
+		t.Fatalf("expected existing ClientIDs to be preserved, got src=%q dst=%q", p.Src.ClientID, p.Dst.ClientID)
+	}
+}
+
+func TestApplyClientOptionsOverrideGeneratesFresh(t *testing.T) {
+	p := newTestPath()
+	p.ApplyClientOptions(HandshakeOptions{Override: true})
+	if p.Src.ClientID == "existing-client" || p.Dst.ClientID == "existing-client" {
+		t.Fatalf("expected Override to regenerate ClientIDs, got src=%q dst=%q", p.Src.ClientID, p.Dst.ClientID)
+	}
+	if len(p.Src.ClientID) != 10 || len(p.Dst.ClientID) != 10 {
+		t.Fatalf("expected default 10-char random ClientIDs, got src=%q dst=%q", p.Src.ClientID, p.Dst.ClientID)
+	}
+}
+
+func TestApplyConnectionOptionsOverrideGeneratesFresh(t *testing.T) {
+	p := newTestPath()
+	p.ApplyConnectionOptions(HandshakeOptions{Override: true})
+	if p.Src.ConnectionID == "existing-conn" || p.Dst.ConnectionID == "existing-conn" {
+		t.Fatalf("expected Override to regenerate ConnectionIDs, got src=%q dst=%q", p.Src.ConnectionID, p.Dst.ConnectionID)
+	}
+}
+
+func TestApplyChannelOptionsOverrideGeneratesFresh(t *testing.T) {
+	p := newTestPath()
+	p.ApplyChannelOptions(HandshakeOptions{Override: true})
+	if p.Src.ChannelID == "existing-chan" || p.Dst.ChannelID == "existing-chan" {
+		t.Fatalf("expected Override to regenerate ChannelIDs, got src=%q dst=%q", p.Src.ChannelID, p.Dst.ChannelID)
+	}
+}
+
+func TestApplyChannelOptionsPreservesExistingByDefault(t *testing.T) {
+	p := newTestPath()
+	p.ApplyChannelOptions(HandshakeOptions{Override: false})
+	if p.Src.ChannelID != "existing-chan" || p.Dst.ChannelID != "existing-chan" {
+		t.Fatalf("expected existing ChannelIDs to be preserved, got src=%q dst=%q", p.Src.ChannelID, p.Dst.ChannelID)
+	}
+}
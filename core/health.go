@@ -0,0 +1,173 @@
+package core
+
+import (
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// HealthServer exposes the relayer's path statuses over HTTP for liveness/
+// readiness probing and Prometheus scraping, so a long-running relayer
+// daemon can be monitored the same way any other service is.
+type HealthServer struct {
+	registry *prometheus.Registry
+
+	handshakeState *prometheus.GaugeVec
+	packetsRelayed *prometheus.CounterVec
+	acksRelayed    *prometheus.CounterVec
+	rpcLatency     *prometheus.HistogramVec
+	txLatency      *prometheus.HistogramVec
+
+	mu       sync.RWMutex
+	statuses map[string]*PathWithStatus
+}
+
+// NewHealthServer returns a HealthServer with its own Prometheus registry.
+func NewHealthServer() *HealthServer {
+	registry := prometheus.NewRegistry()
+	factory := promauto.With(registry)
+	return &HealthServer{
+		registry: registry,
+		statuses: map[string]*PathWithStatus{},
+		handshakeState: factory.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: "yui_relayer",
+			Name:      "path_handshake_state",
+			Help:      "Handshake completion (1) or not (0) per path and stage (chains, clients, connection, channel).",
+		}, []string{"path", "stage"}),
+		packetsRelayed: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "yui_relayer",
+			Name:      "packets_relayed_total",
+			Help:      "Number of packets relayed per path and direction.",
+		}, []string{"path", "direction"}),
+		acksRelayed: factory.NewCounterVec(prometheus.CounterOpts{
+			Namespace: "yui_relayer",
+			Name:      "acks_relayed_total",
+			Help:      "Number of acknowledgements relayed per path and direction.",
+		}, []string{"path", "direction"}),
+		rpcLatency: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "yui_relayer",
+			Name:      "rpc_latency_seconds",
+			Help:      "Latency of chain RPC queries.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"chain", "method"}),
+		txLatency: factory.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "yui_relayer",
+			Name:      "tx_latency_seconds",
+			Help:      "Latency of submitting and confirming transactions.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"chain"}),
+	}
+}
+
+// SetPathStatus records the latest status for name, updating its handshake
+// state gauges. Call this after each QueryPathStatus poll.
+func (h *HealthServer) SetPathStatus(name string, status *PathWithStatus) {
+	h.mu.Lock()
+	h.statuses[name] = status
+	h.mu.Unlock()
+
+	h.handshakeState.WithLabelValues(name, "chains").Set(boolToFloat(status.Status.Chains))
+	h.handshakeState.WithLabelValues(name, "clients").Set(boolToFloat(status.Status.Clients))
+	h.handshakeState.WithLabelValues(name, "connection").Set(boolToFloat(status.Status.Connection))
+	h.handshakeState.WithLabelValues(name, "channel").Set(boolToFloat(status.Status.Channel))
+}
+
+// RecordPacketRelayed increments the packets-relayed counter for path in the
+// given direction ("src->dst" or "dst->src").
+func (h *HealthServer) RecordPacketRelayed(path, direction string) {
+	h.packetsRelayed.WithLabelValues(path, direction).Inc()
+}
+
+// RecordAckRelayed increments the acks-relayed counter for path in the given
+// direction.
+func (h *HealthServer) RecordAckRelayed(path, direction string) {
+	h.acksRelayed.WithLabelValues(path, direction).Inc()
+}
+
+// ObserveRPCLatency records how long an RPC query against chain took.
+func (h *HealthServer) ObserveRPCLatency(chain, method string, d time.Duration) {
+	h.rpcLatency.WithLabelValues(chain, method).Observe(d.Seconds())
+}
+
+// ObserveTxLatency records how long a transaction submitted to chain took to
+// be included and confirmed.
+func (h *HealthServer) ObserveTxLatency(chain string, d time.Duration) {
+	h.txLatency.WithLabelValues(chain).Observe(d.Seconds())
+}
+
+// Handler returns an http.Handler serving /healthz, /readyz and /metrics.
+//
+//   - /healthz reports liveness: 200 only while every known path's chains
+//     are RPC-reachable (PathStatus.Chains), 503 otherwise.
+//   - /readyz reports readiness: 200 only once at least one path is known
+//     and every known path has Chains, Clients, Connection and Channel all
+//     true, and 503 otherwise (including when no path is known yet).
+//   - /metrics serves the Prometheus exposition format.
+func (h *HealthServer) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", h.serveHealthz)
+	mux.HandleFunc("/readyz", h.serveReadyz)
+	mux.Handle("/metrics", promhttp.HandlerFor(h.registry, promhttp.HandlerOpts{}))
+	return mux
+}
+
+// ListenAndServe starts an HTTP server on addr serving Handler.
+func (h *HealthServer) ListenAndServe(addr string) error {
+	return http.ListenAndServe(addr, h.Handler())
+}
+
+func (h *HealthServer) serveHealthz(w http.ResponseWriter, r *http.Request) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	healthy := true
+	out := make(map[string]bool, len(h.statuses))
+	for name, status := range h.statuses {
+		out[name] = status.Status.Chains
+		if !status.Status.Chains {
+			healthy = false
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !healthy {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+	_ = json.NewEncoder(w).Encode(out)
+}
+
+func (h *HealthServer) serveReadyz(w http.ResponseWriter, r *http.Request) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	ready := len(h.statuses) > 0
+	out := make(map[string]PathStatus, len(h.statuses))
+	for name, status := range h.statuses {
+		out[name] = status.Status
+		if !status.Status.Chains || !status.Status.Clients || !status.Status.Connection || !status.Status.Channel {
+			ready = false
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if !ready {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+	_ = json.NewEncoder(w).Encode(out)
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,53 @@
+package core
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func doGet(t *testing.T, handler http.Handler, path string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodGet, path, nil)
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestHealthServerHealthzReflectsRPCReachability(t *testing.T) {
+	h := NewHealthServer()
+	handler := h.Handler()
+
+	h.SetPathStatus("demo", &PathWithStatus{Status: PathStatus{Chains: true}})
+	if rec := doGet(t, handler, "/healthz"); rec.Code != http.StatusOK {
+		t.Fatalf("healthz with Chains=true = %d, want %d", rec.Code, http.StatusOK)
+	}
+
+	h.SetPathStatus("demo", &PathWithStatus{Status: PathStatus{Chains: false}})
+	if rec := doGet(t, handler, "/healthz"); rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("healthz with Chains=false = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+}
+
+func TestHealthServerReadyzRequiresAllStatuses(t *testing.T) {
+	h := NewHealthServer()
+	handler := h.Handler()
+
+	h.SetPathStatus("demo", &PathWithStatus{Status: PathStatus{Chains: true, Clients: true}})
+	if rec := doGet(t, handler, "/readyz"); rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("readyz with partial status = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+
+	h.SetPathStatus("demo", &PathWithStatus{Status: PathStatus{Chains: true, Clients: true, Connection: true, Channel: true}})
+	if rec := doGet(t, handler, "/readyz"); rec.Code != http.StatusOK {
+		t.Fatalf("readyz with full status = %d, want %d", rec.Code, http.StatusOK)
+	}
+}
+
+func TestHealthServerReadyzEmptyIsNotReady(t *testing.T) {
+	h := NewHealthServer()
+	rec := doGet(t, h.Handler(), "/readyz")
+	if rec.Code != http.StatusServiceUnavailable {
+		t.Fatalf("readyz with no known paths = %d, want %d", rec.Code, http.StatusServiceUnavailable)
+	}
+}
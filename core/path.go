@@ -3,8 +3,11 @@ package core
 import (
 	"context"
 	"crypto/rand"
+	"encoding/binary"
 	"fmt"
+	"hash/fnv"
 	"math/big"
+	mathrand "math/rand"
 	"strings"
 
 	"golang.org/x/sync/errgroup"
@@ -105,25 +108,105 @@ type Path struct {
 	Src      *PathEnd     `yaml:"src" json:"src"`
 	Dst      *PathEnd     `yaml:"dst" json:"dst"`
 	Strategy *StrategyCfg `yaml:"strategy" json:"strategy"`
+	// IDPolicy controls how GenSrc*ID/GenDst*ID generate identifiers. A nil
+	// IDPolicy preserves the previous behavior of unconditional
+	// crypto/rand-backed random strings.
+	IDPolicy *IDPolicy `yaml:"id_policy,omitempty" json:"id_policy,omitempty"`
+}
+
+// IDPolicy configures how client, connection and channel identifiers are
+// generated for a Path, as an alternative to the opaque random strings
+// produced by crypto/rand. This makes identifiers human-readable (e.g.
+// "demo-client-0") and, with a fixed Seed, reproducible across runs for
+// testing and CI.
+type IDPolicy struct {
+	// Prefix is prepended to every generated identifier, e.g. "demo-".
+	Prefix string `yaml:"prefix,omitempty" json:"prefix,omitempty"`
+	// Length is the number of random characters to generate when not using
+	// UseSequential. Defaults to 10 if <= 0.
+	Length int `yaml:"length,omitempty" json:"length,omitempty"`
+	// Seed, if non-empty, makes generation deterministic: the same Seed
+	// always produces the same sequence of identifiers. If empty,
+	// crypto/rand is used instead.
+	Seed []byte `yaml:"seed,omitempty" json:"seed,omitempty"`
+	// UseSequential produces identifiers of the form "<prefix><kind>-<n>"
+	// (e.g. "demo-client-0") instead of random characters, counting up
+	// every time an identifier of that kind is generated.
+	UseSequential bool `yaml:"use_sequential,omitempty" json:"use_sequential,omitempty"`
+
+	counters map[string]uint64
+}
+
+// next returns the next identifier of the given kind (e.g. "client", "conn",
+// "chan") according to the policy.
+func (ip *IDPolicy) next(kind string) string {
+	if ip.UseSequential {
+		if ip.counters == nil {
+			ip.counters = map[string]uint64{}
+		}
+		n := ip.counters[kind]
+		ip.counters[kind] = n + 1
+		return fmt.Sprintf("%s%s-%d", ip.Prefix, kind, n)
+	}
+	length := ip.Length
+	if length <= 0 {
+		length = 10
+	}
+	if len(ip.Seed) == 0 {
+		return ip.Prefix + RandLowerCaseLetterString(length)
+	}
+	if ip.counters == nil {
+		ip.counters = map[string]uint64{}
+	}
+	n := ip.counters[kind]
+	ip.counters[kind] = n + 1
+	return ip.Prefix + seededLowerCaseLetterString(ip.Seed, kind, n, length)
+}
+
+// seededLowerCaseLetterString deterministically derives a lowercase letter
+// string from seed, kind and call index n, so repeated calls with the same
+// inputs always produce the same output.
+func seededLowerCaseLetterString(seed []byte, kind string, n uint64, length int) string {
+	h := fnv.New64a()
+	_, _ = h.Write(seed)
+	_, _ = h.Write([]byte(kind))
+	_ = binary.Write(h, binary.BigEndian, n)
+
+	chars := []rune("abcdefghijklmnopqrstuvwxyz")
+	r := mathrand.New(mathrand.NewSource(int64(h.Sum64())))
+	var b strings.Builder
+	for i := 0; i < length; i++ {
+		b.WriteRune(chars[r.Intn(len(chars))])
+	}
+	return b.String()
+}
+
+// genID generates an identifier of the given kind using p.IDPolicy if set,
+// or falls back to the previous unconditional random behavior.
+func (p *Path) genID(kind string) string {
+	if p.IDPolicy != nil {
+		return p.IDPolicy.next(kind)
+	}
+	return RandLowerCaseLetterString(10)
 }
 
 // GenSrcClientID generates the specififed identifier
-func (p *Path) GenSrcClientID() { p.Src.ClientID = RandLowerCaseLetterString(10) }
+func (p *Path) GenSrcClientID() { p.Src.ClientID = p.genID("client") }
 
 // GenDstClientID generates the specififed identifier
-func (p *Path) GenDstClientID() { p.Dst.ClientID = RandLowerCaseLetterString(10) }
+func (p *Path) GenDstClientID() { p.Dst.ClientID = p.genID("client") }
 
 // GenSrcConnID generates the specififed identifier
-func (p *Path) GenSrcConnID() { p.Src.ConnectionID = RandLowerCaseLetterString(10) }
+func (p *Path) GenSrcConnID() { p.Src.ConnectionID = p.genID("conn") }
 
 // GenDstConnID generates the specififed identifier
-func (p *Path) GenDstConnID() { p.Dst.ConnectionID = RandLowerCaseLetterString(10) }
+func (p *Path) GenDstConnID() { p.Dst.ConnectionID = p.genID("conn") }
 
 // GenSrcChanID generates the specififed identifier
-func (p *Path) GenSrcChanID() { p.Src.ChannelID = RandLowerCaseLetterString(10) }
+func (p *Path) GenSrcChanID() { p.Src.ChannelID = p.genID("chan") }
 
 // GenDstChanID generates the specififed identifier
-func (p *Path) GenDstChanID() { p.Dst.ChannelID = RandLowerCaseLetterString(10) }
+func (p *Path) GenDstChanID() { p.Dst.ChannelID = p.genID("chan") }
 
 // Ordered returns true if the path is ordered and false if otherwise
 func (p *Path) Ordered() bool {
@@ -169,29 +252,41 @@ func (p *Path) String() string {
 // GenPath generates a path with random client, connection and channel identifiers
 // given chainIDs and portIDs
 func GenPath(srcChainID, dstChainID, srcPortID, dstPortID, order string, version string) *Path {
-	return &Path{
+	return GenPathWithPolicy(srcChainID, dstChainID, srcPortID, dstPortID, order, version, nil)
+}
+
+// GenPathWithPolicy generates a path with client, connection and channel
+// identifiers given chainIDs and portIDs, using policy to control how the
+// identifiers are generated. A nil policy preserves GenPath's previous
+// behavior of unconditional crypto/rand-backed random identifiers; a
+// non-nil policy produces identifiers according to its
+// Prefix/Length/Seed/UseSequential settings (see IDPolicy).
+func GenPathWithPolicy(srcChainID, dstChainID, srcPortID, dstPortID, order string, version string, policy *IDPolicy) *Path {
+	p := &Path{
 		Src: &PathEnd{
-			ChainID:      srcChainID,
-			ClientID:     RandLowerCaseLetterString(10),
-			ConnectionID: RandLowerCaseLetterString(10),
-			ChannelID:    RandLowerCaseLetterString(10),
-			PortID:       srcPortID,
-			Order:        order,
-			Version:      version,
+			ChainID: srcChainID,
+			PortID:  srcPortID,
+			Order:   order,
+			Version: version,
 		},
 		Dst: &PathEnd{
-			ChainID:      dstChainID,
-			ClientID:     RandLowerCaseLetterString(10),
-			ConnectionID: RandLowerCaseLetterString(10),
-			ChannelID:    RandLowerCaseLetterString(10),
-			PortID:       dstPortID,
-			Order:        order,
-			Version:      version,
+			ChainID: dstChainID,
+			PortID:  dstPortID,
+			Order:   order,
+			Version: version,
 		},
 		Strategy: &StrategyCfg{
 			Type: "naive",
 		},
+		IDPolicy: policy,
 	}
+	p.GenSrcClientID()
+	p.GenSrcConnID()
+	p.GenSrcChanID()
+	p.GenDstClientID()
+	p.GenDstConnID()
+	p.GenDstChanID()
+	return p
 }
 
 // PathStatus holds the status of the primatives in the path
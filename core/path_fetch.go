@@ -0,0 +1,200 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ChainIDs maps a Chain Registry chain-name slug (e.g. "cosmoshub") to the
+// chain-id the relayer has that chain configured under locally (e.g.
+// "cosmoshub-4"). It is required when fetching paths because the registry
+// only knows about chain-name slugs, not the relayer's local chain IDs.
+type ChainIDs map[string]string
+
+// PathResolver resolves a named path to its definition, fetching it from
+// wherever the concrete implementation sources path metadata (HTTP registry,
+// git repository, local filesystem, ...). chainIDs supplies the local
+// ChainID to use for each side of the path, keyed by the registry's
+// chain-name slug.
+type PathResolver interface {
+	// Resolve returns the Path registered under name, or an error if it
+	// cannot be found, parsed, or mapped to local chain IDs via chainIDs.
+	Resolve(name string, chainIDs ChainIDs) (*Path, error)
+}
+
+// registryPathEntry mirrors the subset of the Chain Registry's _IBC/<name>.json
+// schema that this relayer needs in order to populate a Path: per-chain
+// client/connection identifiers at the top level, and one or more
+// per-channel entries (port, channel, ordering, version) nested under
+// "channels".
+type registryPathEntry struct {
+	Chain1   registryChainRef       `json:"chain-1"`
+	Chain2   registryChainRef       `json:"chain-2"`
+	Channels []registryChannelEntry `json:"channels"`
+}
+
+// registryChainRef identifies one side of a registry path entry's client and
+// connection.
+type registryChainRef struct {
+	ChainName    string `json:"chain-name"`
+	ClientID     string `json:"client-id"`
+	ConnectionID string `json:"connection-id"`
+}
+
+// registryChannelEntry is one entry of a registryPathEntry's "channels"
+// array.
+type registryChannelEntry struct {
+	Chain1   registryChannelRef `json:"chain-1"`
+	Chain2   registryChannelRef `json:"chain-2"`
+	Ordering string             `json:"ordering"`
+	Version  string             `json:"version"`
+}
+
+// registryChannelRef identifies one side of a registry channel entry.
+type registryChannelRef struct {
+	ChannelID string `json:"channel-id"`
+	PortID    string `json:"port-id"`
+}
+
+// HTTPPathResolver resolves paths from a Chain Registry-style HTTP endpoint,
+// e.g. https://raw.githubusercontent.com/cosmos/chain-registry/master/_IBC/<name>.json
+type HTTPPathResolver struct {
+	BaseURL string
+	Client  *http.Client
+}
+
+// NewHTTPPathResolver returns a resolver rooted at baseURL. If client is nil,
+// http.DefaultClient is used.
+func NewHTTPPathResolver(baseURL string, client *http.Client) *HTTPPathResolver {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &HTTPPathResolver{BaseURL: baseURL, Client: client}
+}
+
+// Resolve implements PathResolver
+func (r *HTTPPathResolver) Resolve(name string, chainIDs ChainIDs) (*Path, error) {
+	url := fmt.Sprintf("%s/%s.json", r.BaseURL, name)
+	resp, err := r.Client.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch path %s: %w", name, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to fetch path %s: unexpected status %s", name, resp.Status)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read path %s: %w", name, err)
+	}
+	var entry registryPathEntry
+	if err := json.Unmarshal(body, &entry); err != nil {
+		return nil, fmt.Errorf("failed to parse path %s: %w", name, err)
+	}
+	path, err := entry.toPath(chainIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to map path %s: %w", name, err)
+	}
+	return path, nil
+}
+
+// toPath converts a registryPathEntry into a Path, using the first entry of
+// Channels (a registry path may list more than one channel over the same
+// client/connection; callers wanting a specific one should resolve and
+// filter Channels themselves via a custom PathResolver). chainIDs must
+// contain a local chain-id for both Chain1.ChainName and Chain2.ChainName.
+func (e registryPathEntry) toPath(chainIDs ChainIDs) (*Path, error) {
+	if len(e.Channels) == 0 {
+		return nil, fmt.Errorf("registry entry has no channels")
+	}
+	srcChainID, ok := chainIDs[e.Chain1.ChainName]
+	if !ok {
+		return nil, fmt.Errorf("no local chain ID configured for registry chain-name %q", e.Chain1.ChainName)
+	}
+	dstChainID, ok := chainIDs[e.Chain2.ChainName]
+	if !ok {
+		return nil, fmt.Errorf("no local chain ID configured for registry chain-name %q", e.Chain2.ChainName)
+	}
+
+	ch := e.Channels[0]
+	order := chantypesUnorderedString
+	if strings.EqualFold(ch.Ordering, "ordered") {
+		order = chantypesOrderedString
+	}
+
+	return &Path{
+		Src: &PathEnd{
+			ChainID:      srcChainID,
+			ClientID:     e.Chain1.ClientID,
+			ConnectionID: e.Chain1.ConnectionID,
+			ChannelID:    ch.Chain1.ChannelID,
+			PortID:       ch.Chain1.PortID,
+			Order:        order,
+			Version:      ch.Version,
+		},
+		Dst: &PathEnd{
+			ChainID:      dstChainID,
+			ClientID:     e.Chain2.ClientID,
+			ConnectionID: e.Chain2.ConnectionID,
+			ChannelID:    ch.Chain2.ChannelID,
+			PortID:       ch.Chain2.PortID,
+			Order:        order,
+			Version:      ch.Version,
+		},
+		Strategy: &StrategyCfg{
+			Type: "naive",
+		},
+	}, nil
+}
+
+const (
+	chantypesOrderedString   = "ORDERED"
+	chantypesUnorderedString = "UNORDERED"
+)
+
+// FetchPath fetches a path definition named name from registryURL using an
+// HTTPPathResolver, validates it and returns it. chainIDs maps the
+// registry's chain-name slugs to the local chain IDs the relayer has them
+// configured under. Use Paths.Fetch to also add the result to a Paths set,
+// or FetchPathWith to plug in a custom resolver (e.g. backed by git or the
+// local filesystem).
+func FetchPath(name, registryURL string, chainIDs ChainIDs) (*Path, error) {
+	return FetchPathWith(name, NewHTTPPathResolver(registryURL, nil), chainIDs)
+}
+
+// FetchPathWith fetches a path definition named name using the given
+// resolver and validates it before returning.
+func FetchPathWith(name string, resolver PathResolver, chainIDs ChainIDs) (*Path, error) {
+	path, err := resolver.Resolve(name, chainIDs)
+	if err != nil {
+		return nil, err
+	}
+	if err := path.Validate(); err != nil {
+		return nil, fmt.Errorf("fetched path %s is invalid: %w", name, err)
+	}
+	return path, nil
+}
+
+// Fetch fetches the named paths from registryURL and adds them to p under
+// the same names, normalizing each via Path.Validate as Paths.Add does.
+func (p Paths) Fetch(registryURL string, chainIDs ChainIDs, names ...string) error {
+	return p.FetchWith(NewHTTPPathResolver(registryURL, nil), chainIDs, names...)
+}
+
+// FetchWith fetches the named paths using resolver and adds them to p under
+// the same names.
+func (p Paths) FetchWith(resolver PathResolver, chainIDs ChainIDs, names ...string) error {
+	for _, name := range names {
+		path, err := resolver.Resolve(name, chainIDs)
+		if err != nil {
+			return err
+		}
+		if err := p.Add(name, path); err != nil {
+			return fmt.Errorf("failed to add fetched path %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package core
+
+import "testing"
+
+func TestRegistryPathEntryToPath(t *testing.T) {
+	entry := registryPathEntry{
+		Chain1: registryChainRef{
+			ChainName:    "chainA",
+			ClientID:     "07-tendermint-0",
+			ConnectionID: "connection-0",
+		},
+		Chain2: registryChainRef{
+			ChainName:    "chainB",
+			ClientID:     "07-tendermint-1",
+			ConnectionID: "connection-1",
+		},
+		Channels: []registryChannelEntry{
+			{
+				Chain1:   registryChannelRef{ChannelID: "channel-0", PortID: "transfer"},
+				Chain2:   registryChannelRef{ChannelID: "channel-1", PortID: "transfer"},
+				Ordering: "unordered",
+				Version:  "ics20-1",
+			},
+		},
+	}
+	chainIDs := ChainIDs{"chainA": "chainA-1", "chainB": "chainB-1"}
+
+	path, err := entry.toPath(chainIDs)
+	if err != nil {
+		t.Fatalf("toPath returned error: %v", err)
+	}
+
+	if path.Src.ChainID != "chainA-1" || path.Dst.ChainID != "chainB-1" {
+		t.Fatalf("expected local chain ids, got src=%q dst=%q", path.Src.ChainID, path.Dst.ChainID)
+	}
+	if path.Src.ClientID != "07-tendermint-0" || path.Dst.ClientID != "07-tendermint-1" {
+		t.Fatalf("unexpected client ids: src=%q dst=%q", path.Src.ClientID, path.Dst.ClientID)
+	}
+	if path.Src.ChannelID != "channel-0" || path.Dst.ChannelID != "channel-1" {
+		t.Fatalf("unexpected channel ids: src=%q dst=%q", path.Src.ChannelID, path.Dst.ChannelID)
+	}
+	if path.Src.Order != "UNORDERED" || path.Dst.Order != "UNORDERED" {
+		t.Fatalf("unexpected order: src=%q dst=%q", path.Src.Order, path.Dst.Order)
+	}
+	if path.Src.Version != "ics20-1" {
+		t.Fatalf("unexpected version: %q", path.Src.Version)
+	}
+	if path.Strategy == nil || path.Strategy.Type != "naive" {
+		t.Fatalf("expected naive strategy, got %+v", path.Strategy)
+	}
+}
+
+func TestRegistryPathEntryToPathOrdered(t *testing.T) {
+	entry := registryPathEntry{
+		Chain1:   registryChainRef{ChainName: "chainA"},
+		Chain2:   registryChainRef{ChainName: "chainB"},
+		Channels: []registryChannelEntry{{Ordering: "ordered"}},
+	}
+	chainIDs := ChainIDs{"chainA": "chainA-1", "chainB": "chainB-1"}
+
+	path, err := entry.toPath(chainIDs)
+	if err != nil {
+		t.Fatalf("toPath returned error: %v", err)
+	}
+	if path.Src.Order != "ORDERED" || path.Dst.Order != "ORDERED" {
+		t.Fatalf("unexpected order: src=%q dst=%q", path.Src.Order, path.Dst.Order)
+	}
+}
+
+func TestRegistryPathEntryToPathNoChannels(t *testing.T) {
+	entry := registryPathEntry{
+		Chain1: registryChainRef{ChainName: "chainA"},
+		Chain2: registryChainRef{ChainName: "chainB"},
+	}
+	chainIDs := ChainIDs{"chainA": "chainA-1", "chainB": "chainB-1"}
+
+	if _, err := entry.toPath(chainIDs); err == nil {
+		t.Fatal("expected an error for a registry entry with no channels")
+	}
+}
+
+func TestRegistryPathEntryToPathMissingLocalChainID(t *testing.T) {
+	entry := registryPathEntry{
+		Chain1:   registryChainRef{ChainName: "chainA"},
+		Chain2:   registryChainRef{ChainName: "chainB"},
+		Channels: []registryChannelEntry{{}},
+	}
+
+	if _, err := entry.toPath(ChainIDs{"chainA": "chainA-1"}); err == nil {
+		t.Fatal("expected an error when chainIDs is missing an entry for chain-2")
+	}
+}
@@ -0,0 +1,81 @@
+package core
+
+import "testing"
+
+func TestSeededLowerCaseLetterStringIsDeterministic(t *testing.T) {
+	seed := []byte("ci-seed")
+	a := seededLowerCaseLetterString(seed, "client", 0, 10)
+	b := seededLowerCaseLetterString(seed, "client", 0, 10)
+	if a != b {
+		t.Fatalf("seededLowerCaseLetterString not deterministic: %q != %q", a, b)
+	}
+	if len(a) != 10 {
+		t.Fatalf("seededLowerCaseLetterString length = %d, want 10", len(a))
+	}
+}
+
+func TestSeededLowerCaseLetterStringVariesByInput(t *testing.T) {
+	seed := []byte("ci-seed")
+	byKind := seededLowerCaseLetterString(seed, "conn", 0, 10)
+	byClient := seededLowerCaseLetterString(seed, "client", 0, 10)
+	if byKind == byClient {
+		t.Fatalf("expected different kinds to produce different strings, both were %q", byKind)
+	}
+	byIndex := seededLowerCaseLetterString(seed, "client", 1, 10)
+	if byIndex == byClient {
+		t.Fatalf("expected different call indices to produce different strings, both were %q", byClient)
+	}
+}
+
+func TestIDPolicyNextSequential(t *testing.T) {
+	ip := &IDPolicy{Prefix: "demo-", UseSequential: true}
+	if got, want := ip.next("client"), "demo-client-0"; got != want {
+		t.Errorf("first client id = %q, want %q", got, want)
+	}
+	if got, want := ip.next("client"), "demo-client-1"; got != want {
+		t.Errorf("second client id = %q, want %q", got, want)
+	}
+	if got, want := ip.next("conn"), "demo-conn-0"; got != want {
+		t.Errorf("first conn id = %q, want %q", got, want)
+	}
+}
+
+func TestIDPolicyNextSeededIsReproducible(t *testing.T) {
+	ip1 := &IDPolicy{Seed: []byte("fixed-seed"), Length: 8}
+	ip2 := &IDPolicy{Seed: []byte("fixed-seed"), Length: 8}
+	for i := 0; i < 3; i++ {
+		a := ip1.next("client")
+		b := ip2.next("client")
+		if a != b {
+			t.Fatalf("call %d: ip1=%q ip2=%q, want equal", i, a, b)
+		}
+	}
+}
+
+func TestGenPathDefaultsToRandomPolicy(t *testing.T) {
+	p := GenPath("srcchain", "dstchain", "transfer", "transfer", "UNORDERED", "ics20-1")
+	if p.IDPolicy != nil {
+		t.Fatalf("GenPath should leave IDPolicy nil, got %+v", p.IDPolicy)
+	}
+	if len(p.Src.ClientID) != 10 || len(p.Dst.ClientID) != 10 {
+		t.Fatalf("expected default 10-char random client ids, got %q / %q", p.Src.ClientID, p.Dst.ClientID)
+	}
+}
+
+func TestGenPathWithPolicySequential(t *testing.T) {
+	policy := &IDPolicy{Prefix: "demo-", UseSequential: true}
+	p := GenPathWithPolicy("srcchain", "dstchain", "transfer", "transfer", "UNORDERED", "ics20-1", policy)
+
+	if got, want := p.Src.ClientID, "demo-client-0"; got != want {
+		t.Errorf("Src.ClientID = %q, want %q", got, want)
+	}
+	if got, want := p.Dst.ClientID, "demo-client-1"; got != want {
+		t.Errorf("Dst.ClientID = %q, want %q", got, want)
+	}
+	if got, want := p.Src.ConnectionID, "demo-conn-0"; got != want {
+		t.Errorf("Src.ConnectionID = %q, want %q", got, want)
+	}
+	if got, want := p.Src.ChannelID, "demo-chan-0"; got != want {
+		t.Errorf("Src.ChannelID = %q, want %q", got, want)
+	}
+}